@@ -6,33 +6,33 @@ import (
 )
 
 // AdjacencyList represents a linked-list of vertices connected by edges in the digraph
-type AdjacencyList struct {
+type AdjacencyList[T comparable] struct {
 	sync.RWMutex
 	list *list.List
 }
 
 // NewAdjacencyList returns a new AdjacencyList with its internal list initialized
-func NewAdjacencyList() *AdjacencyList {
-	return &AdjacencyList{
+func NewAdjacencyList[T comparable]() *AdjacencyList[T] {
+	return &AdjacencyList[T]{
 		list: list.New(),
 	}
 }
 
 // Adjacent returns all vertices from the adjacency list
-func (a *AdjacencyList) Adjacent() []Vertex {
+func (a *AdjacencyList[T]) Adjacent() []T {
 	// Make sure list is not being modified while finding adjacent vertices
 	a.RLock()
 	defer a.RUnlock()
 
 	// Slice of vertices to return
-	vertices := make([]Vertex, 0)
+	vertices := make([]T, 0)
 
 	// Check for front vertex
 	element := a.list.Front()
 	if element == nil {
 		return nil
 	}
-	vertices = append(vertices, element.Value)
+	vertices = append(vertices, element.Value.(T))
 
 	// Iterate all remaining vertices
 	for {
@@ -43,7 +43,7 @@ func (a *AdjacencyList) Adjacent() []Vertex {
 		}
 
 		// Append vertex
-		vertices = append(vertices, element.Value)
+		vertices = append(vertices, element.Value.(T))
 	}
 
 	// Return all vertices
@@ -51,20 +51,21 @@ func (a *AdjacencyList) Adjacent() []Vertex {
 }
 
 // Search traverses the adjancency list and attempts to find a specified vertex
-func (a *AdjacencyList) Search(target Vertex) Vertex {
+func (a *AdjacencyList[T]) Search(target T) (T, bool) {
 	// Make sure list is not being modified while searching
 	a.RLock()
 	defer a.RUnlock()
 
 	// Ensure the list is not empty
 	if a.list == nil || a.list.Len() == 0 {
-		return nil
+		var zero T
+		return zero, false
 	}
 
 	// Get front node, check immediately if it's the correct one
 	element := a.list.Front()
-	if element.Value == target {
-		return element.Value
+	if element.Value.(T) == target {
+		return target, true
 	}
 
 	// Iterate from the front of the list
@@ -76,11 +77,36 @@ func (a *AdjacencyList) Search(target Vertex) Vertex {
 		}
 
 		// Check for result
-		if element.Value == target {
-			return element.Value
+		if element.Value.(T) == target {
+			return target, true
+		}
+	}
+
+	// Not found
+	var zero T
+	return zero, false
+}
+
+// Remove deletes the first occurrence of target from the adjacency list,
+// returning true if a matching vertex was found and removed, or false if
+// target was not present
+func (a *AdjacencyList[T]) Remove(target T) bool {
+	// Make sure list is not being read while removing from it
+	a.Lock()
+	defer a.Unlock()
+
+	if a.list == nil {
+		return false
+	}
+
+	// Iterate the list looking for a matching vertex
+	for element := a.list.Front(); element != nil; element = element.Next() {
+		if element.Value.(T) == target {
+			a.list.Remove(element)
+			return true
 		}
 	}
 
 	// Not found
-	return nil
+	return false
 }
@@ -10,10 +10,10 @@ func TestAdjacent(t *testing.T) {
 	log.Println("TestAdjacent()")
 
 	// Create an adjacency list
-	adjList := NewAdjacencyList()
+	adjList := NewAdjacencyList[int]()
 
 	// Generate some adjacent vertices for the list
-	elements := []Vertex{1, 2, 3, 4, 5, 6}
+	elements := []int{1, 2, 3, 4, 5, 6}
 	for _, e := range elements {
 		adjList.list.PushBack(e)
 	}
@@ -31,39 +31,38 @@ func TestSearch(t *testing.T) {
 	log.Println("TestSearch()")
 
 	// Create an adjacency list
-	adjList := NewAdjacencyList()
+	adjList := NewAdjacencyList[int]()
 
 	// Generate some adjacent vertices for the list
-	elements := []Vertex{1, 2, 3}
+	elements := []int{1, 2, 3}
 	for _, e := range elements {
 		adjList.list.PushBack(e)
 	}
 
 	// Create a table of tests and expected element results
 	var tests = []struct {
-		vertex interface{}
-		result interface{}
+		vertex int
+		found  bool
 	}{
 		// Existing vertices
-		{1, 1},
-		{2, 2},
-		{3, 3},
+		{1, true},
+		{2, true},
+		{3, true},
 		// Non-existant vertices
-		{4, nil},
-		{5, nil},
-		{6, nil},
+		{4, false},
+		{5, false},
+		{6, false},
 	}
 
 	// Iterate test table, check results
 	for _, test := range tests {
 		// Check for element
-		element := adjList.Search(test.vertex)
+		element, found := adjList.Search(test.vertex)
 
-		// If element is nil and it should not be, test fails
-		if element == nil && test.result != nil {
-			t.Fatalf("adjList.Search(%d) - unexpected result: nil", test.vertex)
-		} else if element != nil && element != test.result {
-			// If element is not nil, but is value does not match, test fails
+		if found != test.found {
+			t.Fatalf("adjList.Search(%d) - unexpected result: %t", test.vertex, found)
+		}
+		if found && element != test.vertex {
 			t.Fatalf("adjList.Search(%d) - unexpected result: %v", test.vertex, element)
 		}
 	}
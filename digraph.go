@@ -1,3 +1,13 @@
+// Package digraph implements a directed graph (a "digraph") data structure,
+// along with common graph algorithms such as topological sort, depth/breadth
+// first traversal, transitive reduction, and weighted shortest paths.
+//
+// BREAKING CHANGE: Digraph is now generic, declared as Digraph[T comparable],
+// and the constructor is New[T comparable]() rather than the old zero-
+// argument New(). Existing call sites of the form digraph.New() will not
+// compile as-is; update them to either supply a concrete vertex type (for
+// example digraph.New[int]()) or call digraph.NewAny(), which returns a
+// *Digraph[any] matching the pre-generics API's behavior.
 package digraph
 
 import (
@@ -16,6 +26,9 @@ var (
 	// ErrEdgeExists is returned when an edge between two vertices already exists
 	ErrEdgeExists = errors.New("digraph: edge already exists")
 
+	// ErrEdgeNotExists is returned when removing an edge which does not exist
+	ErrEdgeNotExists = errors.New("digraph: edge does not exist")
+
 	// ErrVertexExists is returned when a vertex with the same value already exists
 	ErrVertexExists = errors.New("digraph: vertex already exists")
 
@@ -23,27 +36,79 @@ var (
 	ErrVertexNotExists = errors.New("digraph: vertex does not exist")
 )
 
-// Vertex represents a vertex or "node" in the digraph
-type Vertex interface{}
+// Edge represents a directed edge between two vertices in the digraph,
+// optionally carrying a Weight for shortest-path algorithms and arbitrary
+// user Data
+type Edge[T comparable] struct {
+	Source T
+	Target T
+	Weight float64
+	Data   interface{}
+}
+
+// edgeKey identifies an edge by its endpoints, used to key the weight map
+// maintained alongside the adjacency lists
+type edgeKey[T comparable] struct {
+	source T
+	target T
+}
 
-// Digraph represents a "digraph", or directed graph data structure
-type Digraph struct {
+// CycleError is returned when a cycle is detected while computing a
+// topological ordering of the digraph, and identifies the vertex at which
+// the cycle was discovered
+type CycleError[T comparable] struct {
+	vertex T
+}
+
+// Error implements the error interface for CycleError
+func (e *CycleError[T]) Error() string {
+	return fmt.Sprintf("digraph: cycle detected involving vertex %v", e.vertex)
+}
+
+// VertexInvolved returns the vertex at which the cycle was discovered
+func (e *CycleError[T]) VertexInvolved() T {
+	return e.vertex
+}
+
+// Digraph represents a "digraph", or directed graph data structure, whose
+// vertices are comparable values of type T
+type Digraph[T comparable] struct {
 	m           sync.RWMutex
-	adjList     map[Vertex]*AdjacencyList
+	adjList     map[T]*AdjacencyList[T]
+	revAdjList  map[T]*AdjacencyList[T]
+	weights     map[edgeKey[T]]float64
 	edgeCount   int
-	root        Vertex
+	order       []T
+	root        T
+	hasRoot     bool
 	vertexCount int
 }
 
+// AnyDigraph is a Digraph whose vertices may be any type, matching the shape
+// of this package's API prior to the introduction of generics (see the
+// package-level BREAKING CHANGE note). New callers should prefer New[T]()
+// with a concrete, comparable vertex type instead.
+type AnyDigraph = Digraph[any]
+
 // New creates a new acyclic Digraph, and initializes its adjacency list
-func New() *Digraph {
-	return &Digraph{
-		adjList: map[Vertex]*AdjacencyList{},
+func New[T comparable]() *Digraph[T] {
+	return &Digraph[T]{
+		adjList:    map[T]*AdjacencyList[T]{},
+		revAdjList: map[T]*AdjacencyList[T]{},
+		weights:    map[edgeKey[T]]float64{},
 	}
 }
 
+// NewAny creates a new acyclic AnyDigraph, whose vertices may be any type.
+// It is a compatibility shim for callers migrating from the pre-generics
+// API's `New()`, which returned a Digraph accepting any vertex type; new
+// callers should prefer New[T]() with a concrete, comparable vertex type.
+func NewAny() *AnyDigraph {
+	return New[any]()
+}
+
 // AddVertex tries to add a new vertex to the root of the adjacency list on the digraph
-func (d *Digraph) AddVertex(vertex Vertex) error {
+func (d *Digraph[T]) AddVertex(vertex T) error {
 	// Lock digraph while adding vertex
 	d.m.Lock()
 	defer d.m.Unlock()
@@ -54,19 +119,26 @@ func (d *Digraph) AddVertex(vertex Vertex) error {
 	}
 
 	// Check if this vertex is the first to be added to the digraph (the root)
-	if d.root == nil {
+	if !d.hasRoot {
 		d.root = vertex
+		d.hasRoot = true
 	}
 
 	// Add the vertex to the adjacency list, initialize a new linked-list
-	d.adjList[vertex] = NewAdjacencyList()
+	d.adjList[vertex] = NewAdjacencyList[T]()
+
+	// Initialize its reverse adjacency list, used to answer in-degree and
+	// ancestor queries without walking the entire digraph
+	d.revAdjList[vertex] = NewAdjacencyList[T]()
+
+	d.order = append(d.order, vertex)
 	d.vertexCount++
 
 	return nil
 }
 
 // AddEdge tries to add a new edge between two vertices on the adjacency list
-func (d *Digraph) AddEdge(source Vertex, target Vertex) error {
+func (d *Digraph[T]) AddEdge(source T, target T) error {
 	// Ensure vertices are not identical
 	if source == target {
 		return ErrCycle
@@ -103,46 +175,215 @@ func (d *Digraph) AddEdge(source Vertex, target Vertex) error {
 	// Store adjacency list
 	d.adjList[source] = adjList
 
+	// Record the same edge in the reverse adjacency list, keeping it in
+	// lockstep with adjList
+	d.revAdjList[target].list.PushBack(source)
+
+	// Edges created through AddEdge are unweighted; give them a default
+	// weight of 1 so shortest-path algorithms can treat every edge uniformly
+	d.weights[edgeKey[T]{source: source, target: target}] = 1
+
 	return nil
 }
 
-// DepthFirstSearch searches the digraph for the target vertex, using the Depth-First
-// Search algorithm, and returning true if a path to the target is found
-func (d *Digraph) DepthFirstSearch(source Vertex, target Vertex) bool {
-	// Lock completely while performing DFS
+// AddEdges adds multiple edges to the digraph at once. Unlike AddEdge, which
+// performs a depth-first search per call to reject an edge that would
+// introduce a cycle, AddEdges defers cycle detection until all of the edges
+// have been added, and then performs a single topological sort to check the
+// result. This allows bulk loading a large edge set in O(V+E) time, instead
+// of paying for a DFS on every individual edge.
+//
+// AddEdges is atomic with respect to the edges it adds: if any edge in edges
+// is rejected, every edge already added during this call is rolled back
+// before the error is returned, leaving the digraph exactly as it was found.
+// Vertices created along the way by AddVertex are not rolled back, matching
+// AddEdge's existing behavior of leaving a vertex in place even when the
+// edge that introduced it is rejected.
+func (d *Digraph[T]) AddEdges(edges []Edge[T]) error {
+	added := make([]Edge[T], 0, len(edges))
+
+	for _, e := range edges {
+		if e.Source == e.Target {
+			d.rollbackEdges(added)
+			return ErrCycle
+		}
+
+		d.AddVertex(e.Source)
+		d.AddVertex(e.Target)
+
+		if d.HasEdge(e.Source, e.Target) {
+			d.rollbackEdges(added)
+			return ErrEdgeExists
+		}
+
+		d.m.Lock()
+		adjList := d.adjList[e.Source]
+		adjList.list.PushBack(e.Target)
+		d.revAdjList[e.Target].list.PushBack(e.Source)
+		// Unlike AddEdge, which has no Weight to consult and so defaults to
+		// 1, AddEdges stores exactly the Weight the caller specified,
+		// including an explicit zero for a genuine zero-cost edge.
+		d.weights[edgeKey[T]{source: e.Source, target: e.Target}] = e.Weight
+		d.edgeCount++
+		d.m.Unlock()
+
+		added = append(added, e)
+	}
+
+	// A single bulk cycle check replaces the per-edge DFS above.
+	if _, err := d.TopologicalSort(); err != nil {
+		d.rollbackEdges(added)
+		return err
+	}
+
+	return nil
+}
+
+// rollbackEdges removes each of the given edges from the digraph, in
+// reverse of the order they were added, undoing a partially-applied
+// AddEdges call so that the method is atomic: either every edge is added, or
+// none are.
+func (d *Digraph[T]) rollbackEdges(edges []Edge[T]) {
+	for i := len(edges) - 1; i >= 0; i-- {
+		d.RemoveEdge(edges[i].Source, edges[i].Target)
+	}
+}
+
+// Clear removes every vertex and edge from the digraph, resetting it to the
+// same state as a freshly-created Digraph
+func (d *Digraph[T]) Clear() {
 	d.m.Lock()
 	defer d.m.Unlock()
 
-	// Generate a set of locations which have been visited
-	discovered := set.New()
+	d.adjList = map[T]*AdjacencyList[T]{}
+	d.revAdjList = map[T]*AdjacencyList[T]{}
+	d.weights = map[edgeKey[T]]float64{}
+	d.order = nil
+	var zero T
+	d.root = zero
+	d.hasRoot = false
+	d.edgeCount = 0
+	d.vertexCount = 0
+}
+
+// RemoveEdge removes the edge between source and target from the digraph,
+// updating both the adjacency list and reverse adjacency list atomically
+func (d *Digraph[T]) RemoveEdge(source T, target T) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	return d.removeEdgeLocked(source, target)
+}
+
+// removeEdgeLocked implements RemoveEdge, assuming d.m is already held by
+// the caller. It exists so that methods which already hold d.m for the
+// duration of a larger traversal (e.g. TransitiveReduction) can remove an
+// edge without recursively locking the non-reentrant d.m.
+func (d *Digraph[T]) removeEdgeLocked(source T, target T) error {
+	adjList, ok := d.adjList[source]
+	if !ok {
+		return ErrVertexNotExists
+	}
+	if _, ok := d.adjList[target]; !ok {
+		return ErrVertexNotExists
+	}
+
+	if !adjList.Remove(target) {
+		return ErrEdgeNotExists
+	}
+
+	d.revAdjList[target].Remove(source)
+	delete(d.weights, edgeKey[T]{source: source, target: target})
+	d.edgeCount--
+
+	return nil
+}
+
+// RemoveVertex removes vertex v from the digraph, along with every incoming
+// and outgoing edge that references it
+func (d *Digraph[T]) RemoveVertex(v T) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	if _, ok := d.adjList[v]; !ok {
+		return ErrVertexNotExists
+	}
 
-	// Begin recursive Depth-First Search, looking for all vertices reachable from source
-	d.dfs(discovered, source)
+	// Remove v from the adjacency list of every vertex with an edge into v
+	for _, source := range d.revAdjList[v].Adjacent() {
+		d.adjList[source].Remove(v)
+		delete(d.weights, edgeKey[T]{source: source, target: v})
+		d.edgeCount--
+	}
+
+	// Remove v from the reverse adjacency list of every vertex it has an
+	// edge into
+	for _, target := range d.adjList[v].Adjacent() {
+		d.revAdjList[target].Remove(v)
+		delete(d.weights, edgeKey[T]{source: v, target: target})
+		d.edgeCount--
+	}
 
-	// Check if target was discovered during Depth-First Search
-	result := discovered.Has(target)
+	delete(d.adjList, v)
+	delete(d.revAdjList, v)
+	d.vertexCount--
 
-	return result
+	// Drop v from the insertion-order slice used by TopologicalSort and Walk
+	for i, ov := range d.order {
+		if ov == v {
+			d.order = append(d.order[:i], d.order[i+1:]...)
+			break
+		}
+	}
+
+	// Pick a new root if the one being removed was it
+	if d.hasRoot && d.root == v {
+		if len(d.order) > 0 {
+			d.root = d.order[0]
+		} else {
+			var zero T
+			d.root = zero
+			d.hasRoot = false
+		}
+	}
+
+	return nil
 }
 
-// dfs implements a recursive Depth-First Search algorithm
-func (d *Digraph) dfs(discovered *set.Set, target Vertex) {
-	// Get the adjacency list for this vertex
-	adjList := d.adjList[target]
+// DepthFirstSearch searches the digraph for the target vertex, using an
+// iterative Depth-First Search with an explicit stack in place of recursion,
+// and returns true if a path from source to target is found. A recursive
+// traversal would grow the goroutine stack with the digraph's depth; an
+// explicit stack keeps memory use bounded and predictable on deep or wide
+// digraphs. Because this is a read-only traversal, it takes the digraph's
+// RLock rather than its Lock, so concurrent reads no longer serialize behind
+// it.
+func (d *Digraph[T]) DepthFirstSearch(source T, target T) bool {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	// Generate a set of locations which have been visited
+	discovered := map[T]bool{source: true}
+	stack := []T{source}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
 
-	// Check all adjacent vertices
-	for _, v := range adjList.Adjacent() {
-		// Check if vertex has not been discovered
-		if !discovered.Has(v) {
-			// Mark it as discovered, recursively continue traversal
-			discovered.Add(v)
-			d.dfs(discovered, v)
+		for _, w := range d.adjList[v].Adjacent() {
+			if discovered[w] {
+				continue
+			}
+			discovered[w] = true
+			stack = append(stack, w)
 		}
 	}
+
+	return discovered[target]
 }
 
 // EdgeCount returns the number of edges in the digraph
-func (d *Digraph) EdgeCount() int {
+func (d *Digraph[T]) EdgeCount() int {
 	d.m.Lock()
 	defer d.m.Unlock()
 	return d.edgeCount
@@ -150,31 +391,24 @@ func (d *Digraph) EdgeCount() int {
 
 // HasEdge determines if the digraph has an existing edge between source and target,
 // returning true if it does, or false if it does not
-func (d *Digraph) HasEdge(source Vertex, target Vertex) bool {
+func (d *Digraph[T]) HasEdge(source T, target T) bool {
 	// Lock digraph while checking for edges
 	d.m.Lock()
 	defer d.m.Unlock()
 
 	// Check if the source vertex exists
-	if _, found := d.adjList[source]; !found {
+	adjList, found := d.adjList[source]
+	if !found {
 		return false
 	}
 
-	// Retrieve adjacency list for this source
-	adjList := d.adjList[source]
-
 	// Search for target vertex
-	if v := adjList.Search(target); v != nil {
-		// Vertex is adjacent, edge exists
-		return true
-	}
-
-	// No result, edge does not exist
-	return false
+	_, found = adjList.Search(target)
+	return found
 }
 
 // Print displays a printed "tree" of the digraph to the console
-func (d *Digraph) Print(root Vertex, all bool) (string, error) {
+func (d *Digraph[T]) Print(root T, all bool) (string, error) {
 	// Lock completely during print process
 	d.m.Lock()
 	defer d.m.Unlock()
@@ -194,7 +428,7 @@ func (d *Digraph) Print(root Vertex, all bool) (string, error) {
 }
 
 // printRecursive handles the printing of each vertex in "tree" form
-func (d *Digraph) printRecursive(printed *set.Set, vertex Vertex, prefix string, all bool) string {
+func (d *Digraph[T]) printRecursive(printed *set.Set, vertex T, prefix string, all bool) string {
 	// Print the current vertex
 	str := fmt.Sprintf("%s - %v\n", prefix, vertex)
 
@@ -227,7 +461,11 @@ func (d *Digraph) printRecursive(printed *set.Set, vertex Vertex, prefix string,
 }
 
 // String retruns a string representation of the digraph, from the first vertex or "root"
-func (d *Digraph) String() string {
+func (d *Digraph[T]) String() string {
+	if !d.hasRoot {
+		return ""
+	}
+
 	out, err := d.Print(d.root, false)
 	if err != nil {
 		return ""
@@ -236,8 +474,72 @@ func (d *Digraph) String() string {
 	return out
 }
 
+// TopologicalSort returns the vertices of the digraph in dependency order,
+// using Kahn's algorithm: vertices with no remaining incoming edges are
+// repeatedly removed and appended to the result, decrementing the in-degree
+// of their neighbors as they go. Vertices with no incoming edges at the start
+// are taken in insertion order; a vertex freed later is queued behind those
+// already waiting, so vertices ready at the same time are not strictly
+// ordered by insertion. The result is the same across calls on an unmodified
+// digraph.
+//
+// AddEdge already prevents cycles from being created one edge at a time, so
+// TopologicalSort should normally always succeed; it is exposed so that
+// bulk-loading APIs like AddEdges can defer cycle detection to a single
+// O(V+E) pass instead of checking on every edge.
+func (d *Digraph[T]) TopologicalSort() ([]T, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	// Compute the in-degree of every vertex by walking each adjacency list.
+	inDegree := make(map[T]int, len(d.order))
+	for _, v := range d.order {
+		inDegree[v] = 0
+	}
+	for _, v := range d.order {
+		for _, target := range d.adjList[v].Adjacent() {
+			inDegree[target]++
+		}
+	}
+
+	// Seed the queue with vertices that have no incoming edges, in insertion
+	// order.
+	queue := make([]T, 0, len(d.order))
+	for _, v := range d.order {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	sorted := make([]T, 0, len(d.order))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		sorted = append(sorted, v)
+
+		for _, target := range d.adjList[v].Adjacent() {
+			inDegree[target]--
+			if inDegree[target] == 0 {
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	// If not every vertex was removed, a cycle is present among those left
+	// with a nonzero in-degree.
+	if len(sorted) != len(d.order) {
+		for _, v := range d.order {
+			if inDegree[v] > 0 {
+				return nil, &CycleError[T]{vertex: v}
+			}
+		}
+	}
+
+	return sorted, nil
+}
+
 // VertexCount returns the number of vertices in the digraph
-func (d *Digraph) VertexCount() int {
+func (d *Digraph[T]) VertexCount() int {
 	d.m.Lock()
 	defer d.m.Unlock()
 	return d.vertexCount
@@ -10,11 +10,11 @@ func TestAddVertex(t *testing.T) {
 	log.Println("TestAddVertex()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Create a table of tests and expected error results
 	var tests = []struct {
-		vertex interface{}
+		vertex int
 		result error
 	}{
 		// Add vertices which do not exist
@@ -40,12 +40,12 @@ func TestAddEdge(t *testing.T) {
 	log.Println("TestAddEdge()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Create a table of tests and expected error results
 	var tests = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 		result error
 	}{
 		// Add edges which do not exist
@@ -74,12 +74,12 @@ func TestDepthFirstSearch(t *testing.T) {
 	log.Println("TestDepthFirstSearch()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Generate some known paths
 	var paths = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 	}{
 		{1, 2}, {1, 5},
 		{2, 3}, {2, 5},
@@ -95,8 +95,8 @@ func TestDepthFirstSearch(t *testing.T) {
 
 	// Create a table of tests and expected boolean results
 	var tests = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 		result bool
 	}{
 		// Paths reachable between source and target
@@ -122,13 +122,12 @@ func TestEdgeCount(t *testing.T) {
 	log.Println("TestEdgeCount()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Generate some known paths, along with some which will NOT add new edges
-	// TODO: if RemoveEdge methods are added, check those in this test as well
 	var paths = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 	}{
 		// New edge will be created
 		{1, 2}, {1, 5},
@@ -150,6 +149,16 @@ func TestEdgeCount(t *testing.T) {
 			t.Fatalf("graph.EdgeCount() - unexpected result: %d != %d", edgeCount, graph.EdgeCount())
 		}
 	}
+
+	// Removing an edge should decrement the count
+	if err := graph.RemoveEdge(1, 2); err != nil {
+		t.Fatalf("graph.RemoveEdge(1, 2) - unexpected error: %s", err.Error())
+	}
+	edgeCount--
+
+	if edgeCount != graph.EdgeCount() {
+		t.Fatalf("graph.EdgeCount() - unexpected result: %d != %d", edgeCount, graph.EdgeCount())
+	}
 }
 
 // TestHasEdge verifies that the HasEdge method is working properly
@@ -157,12 +166,12 @@ func TestHasEdge(t *testing.T) {
 	log.Println("TestHasEdge()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Generate some known paths, along with some which will NOT add new edges
 	var paths = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 	}{
 		// New edge will be created
 		{1, 2},
@@ -180,10 +189,9 @@ func TestHasEdge(t *testing.T) {
 	}
 
 	// Create a table of tests and expected boolean results
-	// TODO: if RemoveEdge methods are added, check those in this test as well
 	var tests = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 		result bool
 	}{
 		// Existing edges
@@ -202,6 +210,14 @@ func TestHasEdge(t *testing.T) {
 			t.Fatalf("graph.HasEdge(%d, %d) - unexpected result: %t", test.source, test.target, test.result)
 		}
 	}
+
+	// Removing an edge should be reflected by HasEdge
+	if err := graph.RemoveEdge(1, 2); err != nil {
+		t.Fatalf("graph.RemoveEdge(1, 2) - unexpected error: %s", err.Error())
+	}
+	if graph.HasEdge(1, 2) {
+		t.Fatal("graph.HasEdge(1, 2) - unexpected result: true")
+	}
 }
 
 // TestPrint verifies that the Print method is working properly
@@ -209,12 +225,12 @@ func TestPrint(t *testing.T) {
 	log.Println("TestPrint()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Generate some known paths
 	var paths = []struct {
-		source interface{}
-		target interface{}
+		source int
+		target int
 	}{
 		{1, 2}, {1, 4}, {1, 6},
 		{2, 3}, {2, 4}, {2, 5},
@@ -229,7 +245,7 @@ func TestPrint(t *testing.T) {
 
 	// Create a table of tests and expected error results
 	var tests = []struct {
-		root   interface{}
+		root   int
 		result error
 	}{
 		// Existing root vertices
@@ -243,7 +259,7 @@ func TestPrint(t *testing.T) {
 
 	// Iterate test table, check results
 	for _, test := range tests {
-		if err := graph.Print(test.root); err != test.result {
+		if _, err := graph.Print(test.root, false); err != test.result {
 			t.Fatalf("graph.Print(%d) - unexpected result: %s", test.root, err.Error())
 		}
 	}
@@ -254,11 +270,10 @@ func TestVertexCount(t *testing.T) {
 	log.Println("TestVertexCount()")
 
 	// Create a digraph
-	graph := New()
+	graph := New[int]()
 
 	// Generate some vertices, along with some which will NOT add new vertices
-	// TODO: if RemoveVertex methods are added, check those in this test as well
-	var vertices = []Vertex{1, 2, 3, 1, 1, 4, 5, 6}
+	var vertices = []int{1, 2, 3, 1, 1, 4, 5, 6}
 
 	// Create vertices, check vertex count
 	vertexCount := 0
@@ -273,4 +288,132 @@ func TestVertexCount(t *testing.T) {
 			t.Fatalf("graph.VertexCount() - unexpected result: %d != %d", vertexCount, graph.VertexCount())
 		}
 	}
+
+	// Removing a vertex should decrement the count
+	if err := graph.RemoveVertex(1); err != nil {
+		t.Fatalf("graph.RemoveVertex(1) - unexpected error: %s", err.Error())
+	}
+	vertexCount--
+
+	if vertexCount != graph.VertexCount() {
+		t.Fatalf("graph.VertexCount() - unexpected result: %d != %d", vertexCount, graph.VertexCount())
+	}
+}
+
+// TestTopologicalSort verifies that the TopologicalSort method is working properly
+func TestTopologicalSort(t *testing.T) {
+	log.Println("TestTopologicalSort()")
+
+	// Create a digraph
+	graph := New[int]()
+
+	// Generate some known paths
+	var paths = []struct {
+		source int
+		target int
+	}{
+		{1, 2}, {1, 3},
+		{2, 4},
+		{3, 4},
+	}
+
+	// Build paths
+	for _, p := range paths {
+		graph.AddEdge(p.source, p.target)
+	}
+
+	// Sort should succeed, since AddEdge already prevents cycles
+	sorted, err := graph.TopologicalSort()
+	if err != nil {
+		t.Fatalf("graph.TopologicalSort() - unexpected error: %s", err.Error())
+	}
+
+	// Track the position of each vertex in the result
+	position := map[int]int{}
+	for i, v := range sorted {
+		position[v] = i
+	}
+
+	// Verify every edge's source appears before its target
+	for _, p := range paths {
+		if position[p.source] >= position[p.target] {
+			t.Fatalf("graph.TopologicalSort() - %v did not precede %v", p.source, p.target)
+		}
+	}
+}
+
+// TestAddEdges verifies that the AddEdges method is working properly
+func TestAddEdges(t *testing.T) {
+	log.Println("TestAddEdges()")
+
+	// Create a digraph
+	graph := New[int]()
+
+	// Add a batch of acyclic edges
+	edges := []Edge[int]{
+		{Source: 1, Target: 2},
+		{Source: 2, Target: 3},
+	}
+	if err := graph.AddEdges(edges); err != nil {
+		t.Fatalf("graph.AddEdges() - unexpected error: %s", err.Error())
+	}
+
+	if !graph.HasEdge(1, 2) || !graph.HasEdge(2, 3) {
+		t.Fatal("graph.AddEdges() - expected edges were not added")
+	}
+
+	// Add a batch which introduces a cycle
+	cyclic := []Edge[int]{
+		{Source: 3, Target: 1},
+	}
+
+	edgeCountBefore := graph.EdgeCount()
+
+	err := graph.AddEdges(cyclic)
+	if err == nil {
+		t.Fatal("graph.AddEdges() - expected error, got nil")
+	}
+
+	if _, ok := err.(*CycleError[int]); !ok {
+		t.Fatalf("graph.AddEdges() - expected *CycleError, got %T", err)
+	}
+
+	// AddEdges is atomic: a rejected batch must leave the digraph exactly as
+	// it was found, including edges added earlier in the same batch
+	if graph.HasEdge(3, 1) {
+		t.Fatal("graph.AddEdges() - edge from a rejected batch was not rolled back")
+	}
+	if graph.EdgeCount() != edgeCountBefore {
+		t.Fatalf("graph.AddEdges() - edge count changed after a rejected batch: %d != %d", graph.EdgeCount(), edgeCountBefore)
+	}
+
+	// A batch where an earlier edge succeeds before a later edge in the same
+	// batch introduces the cycle must roll back the earlier edge too
+	partial := []Edge[int]{
+		{Source: 4, Target: 1},
+		{Source: 3, Target: 4},
+	}
+
+	if err := graph.AddEdges(partial); err == nil {
+		t.Fatal("graph.AddEdges() - expected error, got nil")
+	}
+
+	if graph.HasEdge(4, 1) || graph.HasEdge(3, 4) {
+		t.Fatal("graph.AddEdges() - earlier edge in a rejected batch was not rolled back")
+	}
+}
+
+// TestNewAny verifies that NewAny produces a working Digraph accepting
+// vertices of any type, for callers migrating from the pre-generics API
+func TestNewAny(t *testing.T) {
+	log.Println("TestNewAny()")
+
+	graph := NewAny()
+	if err := graph.AddEdge("a", 1); err != nil {
+		t.Fatalf("graph.AddEdge() - unexpected error: %s", err.Error())
+	}
+
+	if !graph.HasEdge("a", 1) {
+		t.Fatal("graph.HasEdge() - expected edge between mixed-type vertices was not found")
+	}
 }
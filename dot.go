@@ -0,0 +1,142 @@
+package digraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DOTOptions controls how MarshalDOT renders a digraph as a Graphviz DOT
+// document.
+type DOTOptions[T comparable] struct {
+	// NameFunc renders a vertex as the DOT node name that identifies it.
+	// If nil, fmt.Sprintf("%v", vertex) is used.
+	NameFunc func(vertex T) string
+
+	// VertexAttrs, if non-nil, supplies Graphviz attributes (e.g. "color",
+	// "shape") to attach to a vertex's node statement.
+	VertexAttrs func(vertex T) map[string]string
+
+	// EdgeAttrs, if non-nil, supplies Graphviz attributes to attach to an
+	// edge statement between source and target.
+	EdgeAttrs func(source, target T) map[string]string
+}
+
+// MarshalDOT writes a Graphviz DOT "digraph" document representing d to w.
+// opts may be nil, in which case vertices are rendered with their default
+// string representation and no attributes are attached. This supersedes the
+// ad-hoc tree format produced by Print for anyone who wants to render the
+// digraph with Graphviz or a compatible tool.
+func (d *Digraph[T]) MarshalDOT(w io.Writer, opts *DOTOptions[T]) error {
+	if opts == nil {
+		opts = &DOTOptions[T]{}
+	}
+
+	name := opts.NameFunc
+	if name == nil {
+		name = func(vertex T) string { return fmt.Sprintf("%v", vertex) }
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, err := fmt.Fprintln(w, "digraph {"); err != nil {
+		return err
+	}
+
+	for _, v := range d.order {
+		var attrs map[string]string
+		if opts.VertexAttrs != nil {
+			attrs = opts.VertexAttrs(v)
+		}
+
+		if _, err := fmt.Fprintf(w, "\t%q%s;\n", name(v), formatDOTAttrs(attrs)); err != nil {
+			return err
+		}
+	}
+
+	for _, source := range d.order {
+		for _, target := range d.adjList[source].Adjacent() {
+			var attrs map[string]string
+			if opts.EdgeAttrs != nil {
+				attrs = opts.EdgeAttrs(source, target)
+			}
+
+			if _, err := fmt.Fprintf(w, "\t%q -> %q%s;\n", name(source), name(target), formatDOTAttrs(attrs)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+// formatDOTAttrs renders a Graphviz attribute list, e.g. ` [color="red"]`,
+// with keys sorted for deterministic output. It returns an empty string if
+// attrs is empty.
+func formatDOTAttrs(attrs map[string]string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%q", k, attrs[k])
+	}
+
+	return " [" + strings.Join(pairs, ", ") + "]"
+}
+
+// dotEdgeRe matches a quoted "source" -> "target" edge statement, ignoring
+// any trailing attribute list.
+var dotEdgeRe = regexp.MustCompile(`^"([^"]+)"\s*->\s*"([^"]+)"`)
+
+// dotNodeRe matches a single quoted node statement, ignoring any trailing
+// attribute list.
+var dotNodeRe = regexp.MustCompile(`^"([^"]+)"`)
+
+// UnmarshalDOT parses a simple Graphviz DOT "digraph" document from r and
+// returns the equivalent Digraph with string vertices. Only quoted node
+// names, "source" -> "target" edge statements, and the surrounding
+// "digraph { ... }" wrapper are understood; attribute lists are accepted but
+// discarded.
+func UnmarshalDOT(r io.Reader) (*Digraph[string], error) {
+	graph := New[string]()
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "{" || line == "}" || strings.HasPrefix(line, "digraph") {
+			continue
+		}
+
+		if m := dotEdgeRe.FindStringSubmatch(line); m != nil {
+			if err := graph.AddEdge(m[1], m[2]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if m := dotNodeRe.FindStringSubmatch(line); m != nil {
+			if err := graph.AddVertex(m[1]); err != nil && err != ErrVertexExists {
+				return nil, err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
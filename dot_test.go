@@ -0,0 +1,83 @@
+package digraph
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+)
+
+// TestMarshalDOT verifies that MarshalDOT produces a well-formed DOT document
+func TestMarshalDOT(t *testing.T) {
+	log.Println("TestMarshalDOT()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+
+	var buf bytes.Buffer
+	if err := graph.MarshalDOT(&buf, nil); err != nil {
+		t.Fatalf("graph.MarshalDOT() - unexpected error: %s", err.Error())
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "digraph {") {
+		t.Fatalf("graph.MarshalDOT() - missing digraph header: %q", out)
+	}
+	if !strings.Contains(out, `"1" -> "2"`) || !strings.Contains(out, `"2" -> "3"`) {
+		t.Fatalf("graph.MarshalDOT() - missing expected edges: %q", out)
+	}
+}
+
+// TestMarshalDOTWithAttrs verifies that vertex and edge attribute callbacks are honored
+func TestMarshalDOTWithAttrs(t *testing.T) {
+	log.Println("TestMarshalDOTWithAttrs()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+
+	opts := &DOTOptions[int]{
+		VertexAttrs: func(v int) map[string]string {
+			return map[string]string{"color": "blue"}
+		},
+		EdgeAttrs: func(source, target int) map[string]string {
+			return map[string]string{"style": "dashed"}
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := graph.MarshalDOT(&buf, opts); err != nil {
+		t.Fatalf("graph.MarshalDOT() - unexpected error: %s", err.Error())
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, `color="blue"`) {
+		t.Fatalf("graph.MarshalDOT() - missing vertex attribute: %q", out)
+	}
+	if !strings.Contains(out, `style="dashed"`) {
+		t.Fatalf("graph.MarshalDOT() - missing edge attribute: %q", out)
+	}
+}
+
+// TestUnmarshalDOT verifies that UnmarshalDOT parses a DOT document back into a Digraph
+func TestUnmarshalDOT(t *testing.T) {
+	log.Println("TestUnmarshalDOT()")
+
+	dot := `digraph {
+	"a";
+	"b";
+	"a" -> "b";
+}`
+
+	graph, err := UnmarshalDOT(strings.NewReader(dot))
+	if err != nil {
+		t.Fatalf("UnmarshalDOT() - unexpected error: %s", err.Error())
+	}
+
+	if graph.VertexCount() != 2 {
+		t.Fatalf("graph.VertexCount() - unexpected result: %d != 2", graph.VertexCount())
+	}
+	if !graph.HasEdge("a", "b") {
+		t.Fatal("graph.HasEdge(\"a\", \"b\") - expected edge was not parsed")
+	}
+}
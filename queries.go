@@ -0,0 +1,122 @@
+package digraph
+
+// InDegree returns the number of incoming edges for vertex v
+func (d *Digraph[T]) InDegree(v T) (int, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	rev, ok := d.revAdjList[v]
+	if !ok {
+		return 0, ErrVertexNotExists
+	}
+
+	return len(rev.Adjacent()), nil
+}
+
+// OutDegree returns the number of outgoing edges for vertex v
+func (d *Digraph[T]) OutDegree(v T) (int, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	adjList, ok := d.adjList[v]
+	if !ok {
+		return 0, ErrVertexNotExists
+	}
+
+	return len(adjList.Adjacent()), nil
+}
+
+// Degree returns the total number of edges, incoming and outgoing, for vertex v
+func (d *Digraph[T]) Degree(v T) (int, error) {
+	in, err := d.InDegree(v)
+	if err != nil {
+		return 0, err
+	}
+
+	out, err := d.OutDegree(v)
+	if err != nil {
+		return 0, err
+	}
+
+	return in + out, nil
+}
+
+// Roots returns every vertex in the digraph with an in-degree of zero
+func (d *Digraph[T]) Roots() []T {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	roots := make([]T, 0)
+	for _, v := range d.order {
+		if len(d.revAdjList[v].Adjacent()) == 0 {
+			roots = append(roots, v)
+		}
+	}
+
+	return roots
+}
+
+// Leaves returns every vertex in the digraph with an out-degree of zero
+func (d *Digraph[T]) Leaves() []T {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	leaves := make([]T, 0)
+	for _, v := range d.order {
+		if len(d.adjList[v].Adjacent()) == 0 {
+			leaves = append(leaves, v)
+		}
+	}
+
+	return leaves
+}
+
+// Ancestors returns every vertex which can reach v by following edges
+// forward, i.e. every vertex v transitively depends on
+func (d *Digraph[T]) Ancestors(v T) ([]T, error) {
+	return d.reachable(v, true)
+}
+
+// Descendants returns every vertex reachable from v by following edges
+// forward, i.e. every vertex that transitively depends on v
+func (d *Digraph[T]) Descendants(v T) ([]T, error) {
+	return d.reachable(v, false)
+}
+
+// reachable performs a breadth-first search from v, over the reverse
+// adjacency list when ancestors is true, or the adjacency list otherwise,
+// returning every vertex discovered along the way, excluding v itself.
+func (d *Digraph[T]) reachable(v T, ancestors bool) ([]T, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, ok := d.adjList[v]; !ok {
+		return nil, ErrVertexNotExists
+	}
+
+	lists := d.adjList
+	if ancestors {
+		lists = d.revAdjList
+	}
+
+	discovered := map[T]bool{v: true}
+	queue := []T{v}
+	found := make([]T, 0)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, next := range lists[current].Adjacent() {
+			if discovered[next] {
+				continue
+			}
+
+			discovered[next] = true
+			found = append(found, next)
+			queue = append(queue, next)
+		}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,109 @@
+package digraph
+
+import (
+	"log"
+	"testing"
+)
+
+// buildDegreeGraph creates a small digraph shared by the degree and
+// reachability tests below:
+//
+//	1 -> 2 -> 3
+//	     2 -> 4
+func buildDegreeGraph() *Digraph[int] {
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(2, 4)
+	return graph
+}
+
+// TestDegree verifies that InDegree, OutDegree, and Degree are working properly
+func TestDegree(t *testing.T) {
+	log.Println("TestDegree()")
+
+	graph := buildDegreeGraph()
+
+	var tests = []struct {
+		vertex  int
+		inDeg   int
+		outDeg  int
+		allDeg  int
+		wantErr error
+	}{
+		{1, 0, 1, 1, nil},
+		{2, 1, 2, 3, nil},
+		{3, 1, 0, 1, nil},
+		{9, 0, 0, 0, ErrVertexNotExists},
+	}
+
+	for _, test := range tests {
+		in, err := graph.InDegree(test.vertex)
+		if err != test.wantErr {
+			t.Fatalf("graph.InDegree(%v) - unexpected error: %v", test.vertex, err)
+		}
+		if err == nil && in != test.inDeg {
+			t.Fatalf("graph.InDegree(%v) - unexpected result: %d != %d", test.vertex, in, test.inDeg)
+		}
+
+		out, err := graph.OutDegree(test.vertex)
+		if err != test.wantErr {
+			t.Fatalf("graph.OutDegree(%v) - unexpected error: %v", test.vertex, err)
+		}
+		if err == nil && out != test.outDeg {
+			t.Fatalf("graph.OutDegree(%v) - unexpected result: %d != %d", test.vertex, out, test.outDeg)
+		}
+
+		all, err := graph.Degree(test.vertex)
+		if err != test.wantErr {
+			t.Fatalf("graph.Degree(%v) - unexpected error: %v", test.vertex, err)
+		}
+		if err == nil && all != test.allDeg {
+			t.Fatalf("graph.Degree(%v) - unexpected result: %d != %d", test.vertex, all, test.allDeg)
+		}
+	}
+}
+
+// TestRootsAndLeaves verifies that Roots and Leaves are working properly
+func TestRootsAndLeaves(t *testing.T) {
+	log.Println("TestRootsAndLeaves()")
+
+	graph := buildDegreeGraph()
+
+	roots := graph.Roots()
+	if len(roots) != 1 || roots[0] != 1 {
+		t.Fatalf("graph.Roots() - unexpected result: %v", roots)
+	}
+
+	leaves := graph.Leaves()
+	if len(leaves) != 2 || leaves[0] != 3 || leaves[1] != 4 {
+		t.Fatalf("graph.Leaves() - unexpected result: %v", leaves)
+	}
+}
+
+// TestAncestorsAndDescendants verifies that Ancestors and Descendants are working properly
+func TestAncestorsAndDescendants(t *testing.T) {
+	log.Println("TestAncestorsAndDescendants()")
+
+	graph := buildDegreeGraph()
+
+	descendants, err := graph.Descendants(1)
+	if err != nil {
+		t.Fatalf("graph.Descendants(1) - unexpected error: %s", err.Error())
+	}
+	if len(descendants) != 3 {
+		t.Fatalf("graph.Descendants(1) - unexpected result: %v", descendants)
+	}
+
+	ancestors, err := graph.Ancestors(4)
+	if err != nil {
+		t.Fatalf("graph.Ancestors(4) - unexpected error: %s", err.Error())
+	}
+	if len(ancestors) != 2 {
+		t.Fatalf("graph.Ancestors(4) - unexpected result: %v", ancestors)
+	}
+
+	if _, err := graph.Descendants(9); err != ErrVertexNotExists {
+		t.Fatalf("graph.Descendants(9) - unexpected error: %v", err)
+	}
+}
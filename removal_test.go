@@ -0,0 +1,82 @@
+package digraph
+
+import (
+	"log"
+	"testing"
+)
+
+// TestRemoveVertex verifies that RemoveVertex cleans up every incoming and
+// outgoing edge referencing the removed vertex
+func TestRemoveVertex(t *testing.T) {
+	log.Println("TestRemoveVertex()")
+
+	// Create a digraph: 1 -> 2 -> 3, and 1 -> 3
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 3)
+
+	if err := graph.RemoveVertex(2); err != nil {
+		t.Fatalf("graph.RemoveVertex(2) - unexpected error: %s", err.Error())
+	}
+
+	if graph.HasEdge(1, 2) || graph.HasEdge(2, 3) {
+		t.Fatal("graph.RemoveVertex(2) - edges referencing vertex 2 were not removed")
+	}
+	if !graph.HasEdge(1, 3) {
+		t.Fatal("graph.RemoveVertex(2) - unrelated edge was unexpectedly removed")
+	}
+	if in, _ := graph.InDegree(3); in != 1 {
+		t.Fatalf("graph.InDegree(3) - unexpected result: %d != 1", in)
+	}
+
+	if err := graph.RemoveVertex(9); err != ErrVertexNotExists {
+		t.Fatalf("graph.RemoveVertex(9) - unexpected error: %v", err)
+	}
+}
+
+// TestRemoveEdge verifies that RemoveEdge is working properly
+func TestRemoveEdge(t *testing.T) {
+	log.Println("TestRemoveEdge()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+
+	if err := graph.RemoveEdge(1, 2); err != nil {
+		t.Fatalf("graph.RemoveEdge(1, 2) - unexpected error: %s", err.Error())
+	}
+	if graph.HasEdge(1, 2) {
+		t.Fatal("graph.RemoveEdge(1, 2) - edge still present")
+	}
+
+	if err := graph.RemoveEdge(1, 2); err != ErrEdgeNotExists {
+		t.Fatalf("graph.RemoveEdge(1, 2) - unexpected error: %v", err)
+	}
+	if err := graph.RemoveEdge(1, 9); err != ErrVertexNotExists {
+		t.Fatalf("graph.RemoveEdge(1, 9) - unexpected error: %v", err)
+	}
+}
+
+// TestClear verifies that Clear resets the digraph to the empty state of a
+// freshly-created Digraph
+func TestClear(t *testing.T) {
+	log.Println("TestClear()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+
+	graph.Clear()
+
+	if graph.VertexCount() != 0 || graph.EdgeCount() != 0 {
+		t.Fatalf("graph.Clear() - unexpected counts: vertices=%d edges=%d", graph.VertexCount(), graph.EdgeCount())
+	}
+	if graph.HasEdge(1, 2) {
+		t.Fatal("graph.Clear() - edge still present after clear")
+	}
+
+	// The digraph should still be usable after being cleared
+	if err := graph.AddEdge(1, 2); err != nil {
+		t.Fatalf("graph.AddEdge(1, 2) - unexpected error after Clear(): %s", err.Error())
+	}
+}
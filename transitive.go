@@ -0,0 +1,82 @@
+package digraph
+
+import "sort"
+
+// TransitiveReduction removes every edge (u, v) for which an alternative
+// path from u to v already exists through other vertices, leaving the
+// unique minimum-edge digraph with the same reachability relation as d. This
+// is a standard building block for visualizing dependency graphs, since it
+// strips edges implied by transitivity without changing what is reachable
+// from where.
+//
+// The digraph is first put into topological order. Then, for each vertex u,
+// its direct successors are visited in descending topological rank; for
+// each one, every other direct successor of u found in its (memoized)
+// reachable set is pruned, since the edge to it is implied by the longer
+// path through the current successor.
+func (d *Digraph[T]) TransitiveReduction() error {
+	order, err := d.TopologicalSort()
+	if err != nil {
+		return err
+	}
+
+	// Lock for the remainder of the traversal: reachableFrom reads d.adjList
+	// directly, and removeEdgeLocked mutates it, so both must run under the
+	// same lock as every other method that touches the digraph's internals.
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	rank := make(map[T]int, len(order))
+	for i, v := range order {
+		rank[v] = i
+	}
+
+	// reachable memoizes the set of vertices reachable from a given vertex,
+	// since it is recomputed for every successor of every vertex.
+	reachable := make(map[T]map[T]bool, len(order))
+	var reachableFrom func(v T) map[T]bool
+	reachableFrom = func(v T) map[T]bool {
+		if set, ok := reachable[v]; ok {
+			return set
+		}
+
+		set := map[T]bool{}
+		for _, next := range d.adjList[v].Adjacent() {
+			if set[next] {
+				continue
+			}
+			set[next] = true
+			for r := range reachableFrom(next) {
+				set[r] = true
+			}
+		}
+
+		reachable[v] = set
+		return set
+	}
+
+	for _, u := range order {
+		successors := d.adjList[u].Adjacent()
+
+		byRank := make([]T, len(successors))
+		copy(byRank, successors)
+		sort.Slice(byRank, func(i, j int) bool {
+			return rank[byRank[i]] > rank[byRank[j]]
+		})
+
+		for _, v := range byRank {
+			reach := reachableFrom(v)
+
+			for _, other := range successors {
+				if other == v {
+					continue
+				}
+				if reach[other] {
+					d.removeEdgeLocked(u, other)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package digraph
+
+import (
+	"log"
+	"sync"
+	"testing"
+)
+
+// TestTransitiveReduction verifies that TransitiveReduction removes only
+// redundant edges, leaving the same reachability relation intact
+func TestTransitiveReduction(t *testing.T) {
+	log.Println("TestTransitiveReduction()")
+
+	// Create a digraph with a redundant direct edge: 1->3 is implied by
+	// 1->2->3
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 3)
+
+	if err := graph.TransitiveReduction(); err != nil {
+		t.Fatalf("graph.TransitiveReduction() - unexpected error: %s", err.Error())
+	}
+
+	if graph.HasEdge(1, 3) {
+		t.Fatal("graph.TransitiveReduction() - redundant edge 1->3 was not removed")
+	}
+	if !graph.HasEdge(1, 2) || !graph.HasEdge(2, 3) {
+		t.Fatal("graph.TransitiveReduction() - non-redundant edges were removed")
+	}
+
+	descendants, err := graph.Descendants(1)
+	if err != nil {
+		t.Fatalf("graph.Descendants(1) - unexpected error: %s", err.Error())
+	}
+	if len(descendants) != 2 {
+		t.Fatalf("graph.Descendants(1) - reachability changed after reduction: %v", descendants)
+	}
+}
+
+// TestTransitiveReductionConcurrent runs TransitiveReduction concurrently
+// with AddVertex on the same digraph, so that "go test -race" can catch a
+// regression where TransitiveReduction reads d.adjList without holding d.m
+// for its full duration
+func TestTransitiveReductionConcurrent(t *testing.T) {
+	log.Println("TestTransitiveReductionConcurrent()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 3)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			graph.TransitiveReduction()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			graph.AddVertex(1000 + i)
+		}
+	}()
+
+	wg.Wait()
+}
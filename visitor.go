@@ -0,0 +1,167 @@
+package digraph
+
+import "errors"
+
+// ErrSkip is returned by Visitor.EnterVertex to skip the subtree rooted at
+// the vertex just entered, without treating the traversal as having failed.
+// This mirrors the fs.SkipDir sentinel used by filepath.WalkDir.
+var ErrSkip = errors.New("digraph: skip subtree")
+
+// Visitor defines the callbacks invoked by DFS and BFS as they traverse a
+// digraph starting from some source vertex, allowing callers to implement
+// cycle detection, vertex coloring, or other custom analyses without forking
+// the package.
+type Visitor[T comparable] interface {
+	// EnterVertex is called the first time a vertex is discovered. Returning
+	// ErrSkip causes the traversal to skip the vertex's subtree without
+	// aborting; returning any other non-nil error aborts the traversal with
+	// that error.
+	EnterVertex(vertex T) error
+
+	// LeaveVertex is called once every edge discovered from vertex has been
+	// processed. It is not called for a vertex whose EnterVertex returned
+	// ErrSkip.
+	LeaveVertex(vertex T) error
+
+	// DiscoverEdge is called for every outgoing edge considered during
+	// traversal, before its target vertex is visited.
+	DiscoverEdge(source, target T) error
+
+	// Prune reports whether the traversal should stop early. It is checked
+	// before each vertex is visited.
+	Prune() bool
+}
+
+// DFS traverses the digraph starting at source using an iterative,
+// explicit-stack Depth-First Search, invoking visitor's callbacks as it
+// goes. It takes the digraph's RLock for the duration of the traversal,
+// since it performs no writes.
+func (d *Digraph[T]) DFS(source T, visitor Visitor[T]) error {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, ok := d.adjList[source]; !ok {
+		return ErrVertexNotExists
+	}
+
+	if visitor.Prune() {
+		return nil
+	}
+
+	if err := visitor.EnterVertex(source); err != nil {
+		if err == ErrSkip {
+			return nil
+		}
+		return err
+	}
+
+	type frame struct {
+		vertex   T
+		children []T
+		i        int
+	}
+
+	discovered := map[T]bool{source: true}
+	stack := []*frame{{vertex: source, children: d.adjList[source].Adjacent()}}
+
+	for len(stack) > 0 {
+		if visitor.Prune() {
+			return nil
+		}
+
+		top := stack[len(stack)-1]
+
+		if top.i >= len(top.children) {
+			if err := visitor.LeaveVertex(top.vertex); err != nil {
+				return err
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		child := top.children[top.i]
+		top.i++
+
+		if err := visitor.DiscoverEdge(top.vertex, child); err != nil {
+			return err
+		}
+
+		if discovered[child] {
+			continue
+		}
+		discovered[child] = true
+
+		if err := visitor.EnterVertex(child); err != nil {
+			if err == ErrSkip {
+				continue
+			}
+			return err
+		}
+
+		stack = append(stack, &frame{vertex: child, children: d.adjList[child].Adjacent()})
+	}
+
+	return nil
+}
+
+// BFS traverses the digraph starting at source using an iterative,
+// queue-based Breadth-First Search, invoking visitor's callbacks as it goes.
+// It takes the digraph's RLock for the duration of the traversal, since it
+// performs no writes.
+func (d *Digraph[T]) BFS(source T, visitor Visitor[T]) error {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, ok := d.adjList[source]; !ok {
+		return ErrVertexNotExists
+	}
+
+	if visitor.Prune() {
+		return nil
+	}
+
+	if err := visitor.EnterVertex(source); err != nil {
+		if err == ErrSkip {
+			return nil
+		}
+		return err
+	}
+
+	discovered := map[T]bool{source: true}
+	queue := []T{source}
+
+	for len(queue) > 0 {
+		if visitor.Prune() {
+			return nil
+		}
+
+		v := queue[0]
+		queue = queue[1:]
+
+		for _, child := range d.adjList[v].Adjacent() {
+			if err := visitor.DiscoverEdge(v, child); err != nil {
+				return err
+			}
+
+			if discovered[child] {
+				continue
+			}
+			discovered[child] = true
+
+			if err := visitor.EnterVertex(child); err != nil {
+				if err == ErrSkip {
+					continue
+				}
+				return err
+			}
+
+			queue = append(queue, child)
+		}
+
+		if err := visitor.LeaveVertex(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package digraph
+
+import (
+	"errors"
+	"log"
+	"testing"
+)
+
+// recordingVisitor implements Visitor[int], logging every callback it
+// receives and optionally skipping or pruning on demand
+type recordingVisitor struct {
+	entered []int
+	left    []int
+	edges   [][2]int
+	skip    map[int]bool
+	prune   bool
+}
+
+func (v *recordingVisitor) EnterVertex(vertex int) error {
+	v.entered = append(v.entered, vertex)
+	if v.skip[vertex] {
+		return ErrSkip
+	}
+	return nil
+}
+
+func (v *recordingVisitor) LeaveVertex(vertex int) error {
+	v.left = append(v.left, vertex)
+	return nil
+}
+
+func (v *recordingVisitor) DiscoverEdge(source, target int) error {
+	v.edges = append(v.edges, [2]int{source, target})
+	return nil
+}
+
+func (v *recordingVisitor) Prune() bool {
+	return v.prune
+}
+
+// TestDFSOrder verifies that DFS enters a vertex before its children and
+// leaves it only after all of its children have been processed
+func TestDFSOrder(t *testing.T) {
+	log.Println("TestDFSOrder()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+
+	v := &recordingVisitor{}
+	if err := graph.DFS(1, v); err != nil {
+		t.Fatalf("graph.DFS() - unexpected error: %s", err.Error())
+	}
+
+	want := []int{1, 2, 3}
+	if len(v.entered) != len(want) {
+		t.Fatalf("graph.DFS() - unexpected enter order: %v != %v", v.entered, want)
+	}
+	for i := range want {
+		if v.entered[i] != want[i] {
+			t.Fatalf("graph.DFS() - unexpected enter order: %v != %v", v.entered, want)
+		}
+	}
+
+	if v.left[0] != 3 || v.left[len(v.left)-1] != 1 {
+		t.Fatalf("graph.DFS() - vertex left before its children: %v", v.left)
+	}
+}
+
+// TestDFSSkip verifies that returning ErrSkip from EnterVertex prunes the
+// subtree rooted at that vertex without aborting the traversal
+func TestDFSSkip(t *testing.T) {
+	log.Println("TestDFSSkip()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(1, 4)
+
+	v := &recordingVisitor{skip: map[int]bool{2: true}}
+	if err := graph.DFS(1, v); err != nil {
+		t.Fatalf("graph.DFS() - unexpected error: %s", err.Error())
+	}
+
+	for _, entered := range v.entered {
+		if entered == 3 {
+			t.Fatal("graph.DFS() - descendant of skipped vertex was entered")
+		}
+	}
+	if v.entered[len(v.entered)-1] != 4 {
+		t.Fatalf("graph.DFS() - independent vertex was not visited: %v", v.entered)
+	}
+}
+
+// TestDFSError verifies that a non-ErrSkip error returned from a callback
+// aborts the traversal and is propagated to the caller
+func TestDFSError(t *testing.T) {
+	log.Println("TestDFSError()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+
+	errBoom := errors.New("boom")
+
+	err := graph.DFS(1, &stubVisitor{
+		enter: func(vertex int) error {
+			if vertex == 2 {
+				return errBoom
+			}
+			return nil
+		},
+		leave: func(vertex int) error { return nil },
+		edge:  func(source, target int) error { return nil },
+	})
+	if err != errBoom {
+		t.Fatalf("graph.DFS() - unexpected error: %v != %v", err, errBoom)
+	}
+}
+
+// TestBFSOrder verifies that BFS visits vertices in breadth-first order
+func TestBFSOrder(t *testing.T) {
+	log.Println("TestBFSOrder()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(2, 4)
+	graph.AddEdge(3, 4)
+
+	v := &recordingVisitor{}
+	if err := graph.BFS(1, v); err != nil {
+		t.Fatalf("graph.BFS() - unexpected error: %s", err.Error())
+	}
+
+	if v.entered[0] != 1 {
+		t.Fatalf("graph.BFS() - unexpected first vertex: %v", v.entered)
+	}
+	if v.entered[len(v.entered)-1] != 4 {
+		t.Fatalf("graph.BFS() - unexpected last vertex: %v", v.entered)
+	}
+}
+
+// pruningVisitor wraps a recordingVisitor and reports Prune as true once the
+// wrapped visitor has entered at least one vertex, to test that traversal
+// stops partway through rather than never starting at all
+type pruningVisitor struct {
+	recordingVisitor
+}
+
+func (v *pruningVisitor) Prune() bool {
+	return len(v.entered) > 0
+}
+
+// TestBFSPrune verifies that Prune stops the traversal early
+func TestBFSPrune(t *testing.T) {
+	log.Println("TestBFSPrune()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+
+	v := &pruningVisitor{}
+	if err := graph.BFS(1, v); err != nil {
+		t.Fatalf("graph.BFS() - unexpected error: %s", err.Error())
+	}
+
+	if len(v.entered) != 1 {
+		t.Fatalf("graph.BFS() - traversal was not pruned: %v", v.entered)
+	}
+}
+
+// stubVisitor implements Visitor[int] with function fields, for tests that
+// need custom per-callback behavior beyond what recordingVisitor offers
+type stubVisitor struct {
+	enter func(vertex int) error
+	leave func(vertex int) error
+	edge  func(source, target int) error
+}
+
+func (v *stubVisitor) EnterVertex(vertex int) error          { return v.enter(vertex) }
+func (v *stubVisitor) LeaveVertex(vertex int) error          { return v.leave(vertex) }
+func (v *stubVisitor) DiscoverEdge(source, target int) error { return v.edge(source, target) }
+func (v *stubVisitor) Prune() bool                           { return false }
@@ -0,0 +1,222 @@
+package digraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultWalkConcurrency bounds the number of vertices whose fn is running
+// at any given time during a Walk.
+const defaultWalkConcurrency = 8
+
+// MultiError aggregates the errors produced by fn during a Walk, ReverseWalk,
+// or WalkFrom call.
+type MultiError struct {
+	Errors []error
+}
+
+// Error implements the error interface for MultiError.
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("digraph: %d error(s) occurred during walk: %s", len(m.Errors), strings.Join(msgs, "; "))
+}
+
+// Walk calls fn once for every vertex in the digraph, in dependency order:
+// fn is only invoked for a vertex once fn has returned successfully for all
+// of that vertex's predecessors. Independent branches of the digraph run
+// concurrently on a bounded worker pool, modeled on hashicorp/terraform's dag
+// walker.
+//
+// If fn returns an error for a vertex, that vertex's descendants are skipped
+// rather than visited, while unrelated subgraphs continue running to
+// completion. All errors encountered are aggregated into a *MultiError.
+func (d *Digraph[T]) Walk(fn func(T) error) error {
+	return d.walk(context.Background(), fn, false, nil)
+}
+
+// ReverseWalk behaves like Walk, but traverses the transpose of the digraph:
+// fn is invoked for a vertex once fn has returned successfully for all of
+// that vertex's successors.
+func (d *Digraph[T]) ReverseWalk(fn func(T) error) error {
+	return d.walk(context.Background(), fn, true, nil)
+}
+
+// WalkFrom behaves like Walk, but restricts the traversal to root and its
+// descendants.
+func (d *Digraph[T]) WalkFrom(root T, fn func(T) error) error {
+	return d.walk(context.Background(), fn, false, &root)
+}
+
+// WalkContext behaves like Walk, but aborts once ctx is canceled: any vertex
+// not yet dispatched to fn is skipped, exactly as if fn had failed for one of
+// its predecessors, and ctx.Err() is included in the returned *MultiError.
+// Vertices already running are allowed to finish, since fn has no way to be
+// interrupted mid-call.
+func (d *Digraph[T]) WalkContext(ctx context.Context, fn func(T) error) error {
+	return d.walk(ctx, fn, false, nil)
+}
+
+// ReverseWalkContext behaves like ReverseWalk, but aborts on ctx cancellation
+// exactly as WalkContext does.
+func (d *Digraph[T]) ReverseWalkContext(ctx context.Context, fn func(T) error) error {
+	return d.walk(ctx, fn, true, nil)
+}
+
+// WalkFromContext behaves like WalkFrom, but aborts on ctx cancellation
+// exactly as WalkContext does.
+func (d *Digraph[T]) WalkFromContext(ctx context.Context, root T, fn func(T) error) error {
+	return d.walk(ctx, fn, false, &root)
+}
+
+// walk implements Walk, ReverseWalk, WalkFrom, and their Context variants.
+func (d *Digraph[T]) walk(ctx context.Context, fn func(T) error, reverse bool, root *T) error {
+	d.m.RLock()
+
+	// Snapshot the vertex order and adjacency so the walk can run without
+	// holding the digraph lock for its duration.
+	vertices := make([]T, len(d.order))
+	copy(vertices, d.order)
+
+	succ := make(map[T][]T, len(vertices))
+	pred := make(map[T][]T, len(vertices))
+	for _, v := range vertices {
+		for _, target := range d.adjList[v].Adjacent() {
+			succ[v] = append(succ[v], target)
+			pred[target] = append(pred[target], v)
+		}
+	}
+
+	d.m.RUnlock()
+
+	// ReverseWalk simply walks the transpose of the digraph.
+	if reverse {
+		succ, pred = pred, succ
+	}
+
+	// WalkFrom restricts the walk to root and its descendants.
+	if root != nil {
+		include := map[T]bool{*root: true}
+		stack := []T{*root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			for _, next := range succ[v] {
+				if !include[next] {
+					include[next] = true
+					stack = append(stack, next)
+				}
+			}
+		}
+
+		filtered := make([]T, 0, len(include))
+		for _, v := range vertices {
+			if include[v] {
+				filtered = append(filtered, v)
+			}
+		}
+		vertices = filtered
+
+		// Predecessors outside of the restricted set will never run, so
+		// waiting on them would deadlock; drop them from pred.
+		for v, preds := range pred {
+			if !include[v] {
+				continue
+			}
+			kept := make([]T, 0, len(preds))
+			for _, p := range preds {
+				if include[p] {
+					kept = append(kept, p)
+				}
+			}
+			pred[v] = kept
+		}
+	}
+
+	// done[v] is closed once v has finished running (or been skipped).
+	done := make(map[T]chan struct{}, len(vertices))
+	for _, v := range vertices {
+		done[v] = make(chan struct{})
+	}
+
+	var (
+		mu             sync.Mutex
+		failed         = map[T]bool{}
+		errs           []error
+		ctxErrRecorded bool
+		wg             sync.WaitGroup
+		sem            = make(chan struct{}, defaultWalkConcurrency)
+	)
+
+	wg.Add(len(vertices))
+	for _, v := range vertices {
+		v := v
+		go func() {
+			defer wg.Done()
+			defer close(done[v])
+
+			// Wait for every predecessor to finish, short-circuiting this
+			// vertex if any of them failed.
+			skip := false
+			for _, p := range pred[v] {
+				<-done[p]
+
+				mu.Lock()
+				if failed[p] {
+					skip = true
+				}
+				mu.Unlock()
+			}
+
+			if skip {
+				mu.Lock()
+				failed[v] = true
+				mu.Unlock()
+				return
+			}
+
+			// Skip dispatching fn for a vertex once ctx has been canceled,
+			// unblocking any descendants waiting on done[v] the same way a
+			// failed predecessor would. The check follows the wait for a
+			// worker slot, so a vertex queued behind busy workers is skipped
+			// too.
+			sem <- struct{}{}
+			select {
+			case <-ctx.Done():
+				<-sem
+				mu.Lock()
+				failed[v] = true
+				if !ctxErrRecorded {
+					errs = append(errs, ctx.Err())
+					ctxErrRecorded = true
+				}
+				mu.Unlock()
+				return
+			default:
+			}
+
+			err := fn(v)
+			<-sem
+
+			if err != nil {
+				mu.Lock()
+				failed[v] = true
+				errs = append(errs, fmt.Errorf("digraph: vertex %v: %w", v, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &MultiError{Errors: errs}
+}
@@ -0,0 +1,171 @@
+package digraph
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"testing"
+)
+
+// TestWalk verifies that the Walk method visits vertices in dependency order
+func TestWalk(t *testing.T) {
+	log.Println("TestWalk()")
+
+	// Create a digraph
+	graph := New[int]()
+
+	var paths = []struct {
+		source int
+		target int
+	}{
+		{1, 2}, {1, 3},
+		{2, 4},
+		{3, 4},
+	}
+
+	for _, p := range paths {
+		graph.AddEdge(p.source, p.target)
+	}
+
+	var mu sync.Mutex
+	visited := map[int]bool{}
+
+	err := graph.Walk(func(v int) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// Both predecessors of 4 must already have been visited
+		if v == 4 && (!visited[2] || !visited[3]) {
+			t.Fatal("graph.Walk() - vertex 4 visited before its predecessors")
+		}
+
+		visited[v] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("graph.Walk() - unexpected error: %s", err.Error())
+	}
+
+	for _, v := range []int{1, 2, 3, 4} {
+		if !visited[v] {
+			t.Fatalf("graph.Walk() - vertex %v was not visited", v)
+		}
+	}
+}
+
+// TestWalkSkipsDescendants verifies that a vertex's descendants are skipped
+// when fn returns an error for it, while independent subgraphs still run
+func TestWalkSkipsDescendants(t *testing.T) {
+	log.Println("TestWalkSkipsDescendants()")
+
+	// Create a digraph with two independent chains: 1->2 and 3->4
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(3, 4)
+
+	errFailed := errors.New("boom")
+
+	var mu sync.Mutex
+	visited := map[int]bool{}
+
+	err := graph.Walk(func(v int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visited[v] = true
+
+		if v == 1 {
+			return errFailed
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatal("graph.Walk() - expected error, got nil")
+	}
+
+	if _, ok := err.(*MultiError); !ok {
+		t.Fatalf("graph.Walk() - expected *MultiError, got %T", err)
+	}
+
+	if visited[2] {
+		t.Fatal("graph.Walk() - descendant of failed vertex should not have been visited")
+	}
+	if !visited[3] || !visited[4] {
+		t.Fatal("graph.Walk() - independent subgraph should have run to completion")
+	}
+}
+
+// TestWalkFrom verifies that WalkFrom restricts traversal to descendants of root
+func TestWalkFrom(t *testing.T) {
+	log.Println("TestWalkFrom()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(4, 2)
+
+	var mu sync.Mutex
+	visited := map[int]bool{}
+
+	err := graph.WalkFrom(2, func(v int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visited[v] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("graph.WalkFrom() - unexpected error: %s", err.Error())
+	}
+
+	if visited[1] || visited[4] {
+		t.Fatal("graph.WalkFrom() - ancestors of root should not have been visited")
+	}
+	if !visited[2] || !visited[3] {
+		t.Fatal("graph.WalkFrom() - root and its descendants should have been visited")
+	}
+}
+
+// TestWalkContextCancel verifies that WalkContext skips vertices once ctx is
+// canceled, and reports ctx.Err() in the resulting *MultiError
+func TestWalkContextCancel(t *testing.T) {
+	log.Println("TestWalkContextCancel()")
+
+	graph := New[int]()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var mu sync.Mutex
+	visited := map[int]bool{}
+
+	err := graph.WalkContext(ctx, func(v int) error {
+		mu.Lock()
+		defer mu.Unlock()
+		visited[v] = true
+		return nil
+	})
+	if err == nil {
+		t.Fatal("graph.WalkContext() - expected error, got nil")
+	}
+
+	multi, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("graph.WalkContext() - expected *MultiError, got %T", err)
+	}
+
+	found := false
+	for _, e := range multi.Errors {
+		if e == context.Canceled {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("graph.WalkContext() - expected context.Canceled among errors: %v", multi.Errors)
+	}
+
+	if len(visited) != 0 {
+		t.Fatalf("graph.WalkContext() - no vertex should have been visited after cancellation: %v", visited)
+	}
+}
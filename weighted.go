@@ -0,0 +1,229 @@
+package digraph
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNoPath is returned by Dijkstra when no path exists between the
+	// requested source and target vertices
+	ErrNoPath = errors.New("digraph: no path between source and target")
+
+	// ErrNegativeCycle is returned by BellmanFord when the digraph contains a
+	// negative-weight cycle, making shortest paths from source ill-defined.
+	// Since AddEdge and AddEdges both reject edges that would create a
+	// cycle, a Digraph built exclusively through this package's API can
+	// never actually trigger this case (UnmarshalDOT also adds its edges
+	// through AddEdge); it is still detected so that BellmanFord reports a
+	// cycle rather than wrong distances if one is ever present.
+	ErrNegativeCycle = errors.New("digraph: negative-weight cycle detected")
+)
+
+// AddWeightedEdge tries to add a new weighted edge between two vertices on
+// the adjacency list, behaving exactly like AddEdge except that weight is
+// recorded for use by Dijkstra and BellmanFord
+func (d *Digraph[T]) AddWeightedEdge(source T, target T, weight float64) error {
+	if err := d.AddEdge(source, target); err != nil {
+		return err
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	d.weights[edgeKey[T]{source: source, target: target}] = weight
+
+	return nil
+}
+
+// Edges returns every edge currently in the digraph, including its weight
+func (d *Digraph[T]) Edges() []Edge[T] {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	edges := make([]Edge[T], 0, d.edgeCount)
+	for _, source := range d.order {
+		for _, target := range d.adjList[source].Adjacent() {
+			edges = append(edges, Edge[T]{
+				Source: source,
+				Target: target,
+				Weight: d.weights[edgeKey[T]{source: source, target: target}],
+			})
+		}
+	}
+
+	return edges
+}
+
+// EdgesFrom returns every outgoing edge from vertex v, including its weight
+func (d *Digraph[T]) EdgesFrom(v T) []Edge[T] {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	adjList, ok := d.adjList[v]
+	if !ok {
+		return nil
+	}
+
+	adjacent := adjList.Adjacent()
+	edges := make([]Edge[T], 0, len(adjacent))
+	for _, target := range adjacent {
+		edges = append(edges, Edge[T]{
+			Source: v,
+			Target: target,
+			Weight: d.weights[edgeKey[T]{source: v, target: target}],
+		})
+	}
+
+	return edges
+}
+
+// pqItem is a single entry in a Dijkstra priority queue, pairing a vertex
+// with its current best-known distance from the search's source
+type pqItem[T comparable] struct {
+	vertex   T
+	distance float64
+}
+
+// priorityQueue implements container/heap.Interface over a slice of pqItem,
+// ordered by ascending distance
+type priorityQueue[T comparable] []pqItem[T]
+
+func (pq priorityQueue[T]) Len() int            { return len(pq) }
+func (pq priorityQueue[T]) Less(i, j int) bool  { return pq[i].distance < pq[j].distance }
+func (pq priorityQueue[T]) Swap(i, j int)       { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue[T]) Push(x interface{}) { *pq = append(*pq, x.(pqItem[T])) }
+
+func (pq *priorityQueue[T]) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// Dijkstra computes the shortest path from source to target using Dijkstra's
+// algorithm, returning the path (inclusive of both endpoints) and its total
+// weight. It returns ErrNoPath if target is not reachable from source.
+// Dijkstra assumes non-negative edge weights; use BellmanFord if the digraph
+// may contain negative weights.
+func (d *Digraph[T]) Dijkstra(source T, target T) ([]T, float64, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, ok := d.adjList[source]; !ok {
+		return nil, 0, ErrVertexNotExists
+	}
+	if _, ok := d.adjList[target]; !ok {
+		return nil, 0, ErrVertexNotExists
+	}
+
+	dist := make(map[T]float64, len(d.order))
+	prev := make(map[T]T, len(d.order))
+	visited := make(map[T]bool, len(d.order))
+	for _, v := range d.order {
+		dist[v] = math.Inf(1)
+	}
+	dist[source] = 0
+
+	pq := &priorityQueue[T]{{vertex: source, distance: 0}}
+	heap.Init(pq)
+
+	for pq.Len() > 0 {
+		item := heap.Pop(pq).(pqItem[T])
+		v := item.vertex
+
+		if visited[v] {
+			continue
+		}
+		visited[v] = true
+
+		if v == target {
+			break
+		}
+
+		for _, w := range d.adjList[v].Adjacent() {
+			weight := d.weights[edgeKey[T]{source: v, target: w}]
+			if alt := dist[v] + weight; alt < dist[w] {
+				dist[w] = alt
+				prev[w] = v
+				heap.Push(pq, pqItem[T]{vertex: w, distance: alt})
+			}
+		}
+	}
+
+	if !visited[target] {
+		return nil, 0, ErrNoPath
+	}
+
+	// Walk prev back from target to source to reconstruct the path
+	path := []T{target}
+	for v := target; v != source; {
+		p, ok := prev[v]
+		if !ok {
+			return nil, 0, ErrNoPath
+		}
+		path = append(path, p)
+		v = p
+	}
+
+	// Reverse path into source-to-target order
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path, dist[target], nil
+}
+
+// BellmanFord computes the shortest path from source to every other vertex
+// reachable from it, tolerating negative edge weights. It returns a map of
+// distances and a map of predecessors suitable for path reconstruction, or
+// ErrNegativeCycle if a negative-weight cycle is reachable from source.
+func (d *Digraph[T]) BellmanFord(source T) (map[T]float64, map[T]T, error) {
+	d.m.RLock()
+	defer d.m.RUnlock()
+
+	if _, ok := d.adjList[source]; !ok {
+		return nil, nil, ErrVertexNotExists
+	}
+
+	dist := make(map[T]float64, len(d.order))
+	prev := make(map[T]T, len(d.order))
+	for _, v := range d.order {
+		dist[v] = math.Inf(1)
+	}
+	dist[source] = 0
+
+	// Relax every edge |V|-1 times
+	for i := 0; i < len(d.order)-1; i++ {
+		for _, v := range d.order {
+			if math.IsInf(dist[v], 1) {
+				continue
+			}
+			for _, w := range d.adjList[v].Adjacent() {
+				weight := d.weights[edgeKey[T]{source: v, target: w}]
+				if alt := dist[v] + weight; alt < dist[w] {
+					dist[w] = alt
+					prev[w] = v
+				}
+			}
+		}
+	}
+
+	// One further pass: if any distance still improves, a negative cycle is
+	// reachable from source
+	for _, v := range d.order {
+		if math.IsInf(dist[v], 1) {
+			continue
+		}
+		for _, w := range d.adjList[v].Adjacent() {
+			weight := d.weights[edgeKey[T]{source: v, target: w}]
+			if dist[v]+weight < dist[w] {
+				return nil, nil, ErrNegativeCycle
+			}
+		}
+	}
+
+	return dist, prev, nil
+}
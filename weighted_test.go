@@ -0,0 +1,129 @@
+package digraph
+
+import (
+	"log"
+	"testing"
+)
+
+// TestAddWeightedEdge verifies that AddWeightedEdge records the supplied
+// weight, and that Edges/EdgesFrom report it back
+func TestAddWeightedEdge(t *testing.T) {
+	log.Println("TestAddWeightedEdge()")
+
+	graph := New[int]()
+	if err := graph.AddWeightedEdge(1, 2, 5); err != nil {
+		t.Fatalf("graph.AddWeightedEdge() - unexpected error: %s", err.Error())
+	}
+
+	edges := graph.Edges()
+	if len(edges) != 1 {
+		t.Fatalf("graph.Edges() - unexpected result: %d != 1", len(edges))
+	}
+	if edges[0].Weight != 5 {
+		t.Fatalf("graph.Edges() - unexpected weight: %v != 5", edges[0].Weight)
+	}
+
+	from := graph.EdgesFrom(1)
+	if len(from) != 1 || from[0].Target != 2 || from[0].Weight != 5 {
+		t.Fatalf("graph.EdgesFrom(1) - unexpected result: %+v", from)
+	}
+}
+
+// TestAddEdgesZeroWeight verifies that an edge added through AddEdges with an
+// explicit Weight of 0 is stored as a genuine zero-cost edge, rather than
+// being coerced to the default weight AddEdge uses for unweighted edges
+func TestAddEdgesZeroWeight(t *testing.T) {
+	log.Println("TestAddEdgesZeroWeight()")
+
+	graph := New[int]()
+	err := graph.AddEdges([]Edge[int]{
+		{Source: 1, Target: 2, Weight: 0},
+		{Source: 2, Target: 3, Weight: 5},
+	})
+	if err != nil {
+		t.Fatalf("graph.AddEdges() - unexpected error: %s", err.Error())
+	}
+
+	path, dist, err := graph.Dijkstra(1, 3)
+	if err != nil {
+		t.Fatalf("graph.Dijkstra() - unexpected error: %s", err.Error())
+	}
+
+	if dist != 5 {
+		t.Fatalf("graph.Dijkstra() - unexpected distance: %v != 5 (zero-weight edge was coerced)", dist)
+	}
+
+	want := []int{1, 2, 3}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Fatalf("graph.Dijkstra() - unexpected path: %v != %v", path, want)
+		}
+	}
+}
+
+// TestDijkstra verifies that Dijkstra finds the lowest-weight path between
+// two vertices, preferring it over a shorter but more expensive path
+func TestDijkstra(t *testing.T) {
+	log.Println("TestDijkstra()")
+
+	graph := New[int]()
+	graph.AddWeightedEdge(1, 2, 10)
+	graph.AddWeightedEdge(1, 3, 1)
+	graph.AddWeightedEdge(3, 2, 1)
+
+	path, dist, err := graph.Dijkstra(1, 2)
+	if err != nil {
+		t.Fatalf("graph.Dijkstra() - unexpected error: %s", err.Error())
+	}
+
+	if dist != 2 {
+		t.Fatalf("graph.Dijkstra() - unexpected distance: %v != 2", dist)
+	}
+
+	want := []int{1, 3, 2}
+	if len(path) != len(want) {
+		t.Fatalf("graph.Dijkstra() - unexpected path: %v != %v", path, want)
+	}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Fatalf("graph.Dijkstra() - unexpected path: %v != %v", path, want)
+		}
+	}
+}
+
+// TestDijkstraNoPath verifies that Dijkstra reports ErrNoPath when target is
+// unreachable from source
+func TestDijkstraNoPath(t *testing.T) {
+	log.Println("TestDijkstraNoPath()")
+
+	graph := New[int]()
+	graph.AddVertex(1)
+	graph.AddVertex(2)
+
+	if _, _, err := graph.Dijkstra(1, 2); err != ErrNoPath {
+		t.Fatalf("graph.Dijkstra() - unexpected error: %v != %v", err, ErrNoPath)
+	}
+}
+
+// TestBellmanFord verifies that BellmanFord computes correct distances in the
+// presence of a negative-weight edge, which Dijkstra cannot handle correctly
+func TestBellmanFord(t *testing.T) {
+	log.Println("TestBellmanFord()")
+
+	graph := New[int]()
+	graph.AddWeightedEdge(1, 2, 4)
+	graph.AddWeightedEdge(1, 3, 1)
+	graph.AddWeightedEdge(3, 2, -2)
+
+	dist, _, err := graph.BellmanFord(1)
+	if err != nil {
+		t.Fatalf("graph.BellmanFord() - unexpected error: %s", err.Error())
+	}
+
+	if dist[2] != -1 {
+		t.Fatalf("graph.BellmanFord() - unexpected distance: %v != -1", dist[2])
+	}
+	if dist[3] != 1 {
+		t.Fatalf("graph.BellmanFord() - unexpected distance: %v != 1", dist[3])
+	}
+}